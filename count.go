@@ -0,0 +1,232 @@
+package paginator
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// CountStrategy determines how a paginator counts total records. New and
+// NewAdapter default to CountExact; use WithCountStrategy to pick another.
+type CountStrategy interface {
+	// count returns the total record count (or an estimate) for value
+	// through adapter, and whether the total is an estimate.
+	count(adapter Adapter, value interface{}) (total int64, estimate bool, err error)
+}
+
+// EstimatingAdapter is implemented by Adapters that can provide a fast,
+// driver-specific row estimate in place of an exact COUNT(*) query. Adapters
+// that don't implement it fall back to an exact count under CountEstimate.
+type EstimatingAdapter interface {
+	Adapter
+
+	// Estimate returns an approximate row count.
+	Estimate() (int64, error)
+}
+
+// CountCache is implemented by pluggable caches backing CountCached.
+type CountCache interface {
+	// Get returns the cached total for key, if any and not expired.
+	Get(key string) (total int64, ok bool)
+
+	// Set caches total under key for ttl.
+	Set(key string, total int64, ttl time.Duration)
+}
+
+// countStrategyFunc adapts a plain function to CountStrategy.
+type countStrategyFunc func(adapter Adapter) (total int64, estimate bool, err error)
+
+func (f countStrategyFunc) count(adapter Adapter, _ interface{}) (int64, bool, error) {
+	return f(adapter)
+}
+
+// CountExact counts every matching record with an exact COUNT(*) query. This
+// is the default strategy.
+var CountExact CountStrategy = countStrategyFunc(func(adapter Adapter) (int64, bool, error) {
+	total, err := adapter.Count()
+	return total, false, err
+})
+
+// CountEstimate issues a driver-appropriate row estimate instead of an exact
+// COUNT(*), trading precision for speed on large tables. Adapters that
+// implement EstimatingAdapter provide the estimate; others fall back to an
+// exact count.
+var CountEstimate CountStrategy = countStrategyFunc(func(adapter Adapter) (int64, bool, error) {
+	if ea, ok := adapter.(EstimatingAdapter); ok {
+		total, err := ea.Estimate()
+		return total, true, err
+	}
+
+	total, err := adapter.Count()
+	return total, false, err
+})
+
+// noneCountStrategy backs CountNone. It is a distinct type (rather than a
+// countStrategyFunc) so paginateViaAdapter can detect it and skip spawning a
+// count goroutine entirely.
+type noneCountStrategy struct{}
+
+func (noneCountStrategy) count(Adapter, interface{}) (int64, bool, error) {
+	return -1, false, nil
+}
+
+func (noneCountStrategy) skipsCount() {}
+
+// CountNone skips counting entirely. Result.TotalRecords is left at -1 and
+// Result.MaxPage at 0; use this on tables where COUNT(*) is too expensive to
+// run on every page request.
+var CountNone CountStrategy = noneCountStrategy{}
+
+// cachedCountStrategy backs CountCached.
+type cachedCountStrategy struct {
+	cache CountCache
+	ttl   time.Duration
+	keyFn func(value interface{}) string
+}
+
+// CountCached wraps an exact count with a CountCache, reusing a cached total
+// for ttl before issuing another COUNT(*) query. keyFn derives the cache key
+// from the value being paginated, e.g. its table name.
+func CountCached(cache CountCache, ttl time.Duration, keyFn func(value interface{}) string) CountStrategy {
+	return &cachedCountStrategy{cache: cache, ttl: ttl, keyFn: keyFn}
+}
+
+func (s *cachedCountStrategy) count(adapter Adapter, value interface{}) (int64, bool, error) {
+	key := s.keyFn(value)
+
+	if total, ok := s.cache.Get(key); ok {
+		return total, false, nil
+	}
+
+	total, err := adapter.Count()
+	if err != nil {
+		return 0, false, err
+	}
+
+	s.cache.Set(key, total, s.ttl)
+
+	return total, false, nil
+}
+
+// EstimateRows returns a driver-appropriate row-count estimate for value's
+// table, used by dbAdapter and gormadapter.Adapter to implement
+// EstimatingAdapter. Postgres reads the planner's row estimate off
+// pg_class; MySQL reads EXPLAIN's row estimate, falling back to SHOW TABLE
+// STATUS for MyISAM tables where EXPLAIN reports none. Other dialects fall
+// back to an exact COUNT(*).
+func EstimateRows(db *gorm.DB, value interface{}) (int64, error) {
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(value); err != nil {
+		return 0, err
+	}
+
+	table := stmt.Table
+
+	switch db.Dialector.Name() {
+	case "postgres":
+		return estimateRowsPostgres(db, table)
+	case "mysql":
+		return estimateRowsMySQL(db, table)
+	default:
+		var total int64
+		err := db.Table(table).Count(&total).Error
+		return total, err
+	}
+}
+
+// estimateRowsPostgres reads the planner's estimated row count for table out
+// of pg_class.reltuples.
+func estimateRowsPostgres(db *gorm.DB, table string) (int64, error) {
+	var estimate sql.NullFloat64
+
+	err := db.Raw("SELECT reltuples FROM pg_class WHERE relname = ?", table).Row().Scan(&estimate)
+	if err != nil {
+		return 0, err
+	}
+
+	if !estimate.Valid || estimate.Float64 < 0 {
+		var total int64
+		err := db.Table(table).Count(&total).Error
+		return total, err
+	}
+
+	return int64(estimate.Float64), nil
+}
+
+// estimateRowsMySQL reads EXPLAIN's estimated row count for table, falling
+// back to SHOW TABLE STATUS for MyISAM tables, which don't report a
+// meaningful estimate through EXPLAIN.
+func estimateRowsMySQL(db *gorm.DB, table string) (int64, error) {
+	if rows, ok, err := explainRowEstimate(db, table); err != nil {
+		return 0, err
+	} else if ok {
+		return rows, nil
+	}
+
+	var status struct {
+		Rows sql.NullInt64
+	}
+
+	if err := db.Raw("SHOW TABLE STATUS LIKE ?", table).Scan(&status).Error; err != nil {
+		return 0, err
+	}
+
+	return status.Rows.Int64, nil
+}
+
+// explainRowEstimate reads the "rows" column out of EXPLAIN SELECT * FROM
+// table, scanning generically since the column count and order vary across
+// MySQL versions. ok is false when EXPLAIN reported no usable estimate.
+func explainRowEstimate(db *gorm.DB, table string) (rows int64, ok bool, err error) {
+	result, err := db.Raw(fmt.Sprintf("EXPLAIN SELECT * FROM %s", quoteMySQLIdentifier(table))).Rows()
+	if err != nil {
+		return 0, false, err
+	}
+	defer result.Close()
+
+	cols, err := result.Columns()
+	if err != nil {
+		return 0, false, err
+	}
+
+	rowsIdx := -1
+	for i, col := range cols {
+		if strings.EqualFold(col, "rows") {
+			rowsIdx = i
+			break
+		}
+	}
+
+	if rowsIdx == -1 || !result.Next() {
+		return 0, false, nil
+	}
+
+	dest := make([]interface{}, len(cols))
+	for i := range dest {
+		dest[i] = new(sql.RawBytes)
+	}
+
+	if err := result.Scan(dest...); err != nil {
+		return 0, false, err
+	}
+
+	estimate, err := strconv.ParseInt(string(*dest[rowsIdx].(*sql.RawBytes)), 10, 64)
+	if err != nil {
+		return 0, false, nil
+	}
+
+	return estimate, true, nil
+}
+
+// quoteMySQLIdentifier backtick-quotes table for interpolation into raw SQL.
+// table comes from gorm.Statement.Parse, which can reflect a model's own
+// TableName() method, so it isn't safe to interpolate unescaped; MySQL's
+// EXPLAIN doesn't support parameterizing the table name, so quoting (doubling
+// any embedded backtick) is the available defense here.
+func quoteMySQLIdentifier(table string) string {
+	return "`" + strings.ReplaceAll(table, "`", "``") + "`"
+}
@@ -0,0 +1,335 @@
+package paginator
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// CursorDirection defines the sort direction used for a cursor column.
+type CursorDirection int
+
+const (
+	// Asc orders a cursor column in ascending order.
+	Asc CursorDirection = iota
+	// Desc orders a cursor column in descending order.
+	Desc
+)
+
+// CursorColumn defines a single column participating in keyset pagination.
+// Name is the exported struct field (e.g. "ID", "CreatedAt"); PaginateCursor
+// resolves it to its SQL column through the model's gorm schema, and uses Name
+// as the SQL column as-is when the schema has no such field. Columns are
+// compared in the order given,
+// forming a composite key that should be unique (or unique enough) to keep
+// pagination stable.
+type CursorColumn struct {
+	Name      string
+	Direction CursorDirection
+}
+
+// CursorResult defines a paginated result produced by PaginateCursor.
+type CursorResult struct {
+	Records    interface{} `json:"records"`
+	NextCursor string      `json:"nextCursor,omitempty"`
+	PrevCursor string      `json:"prevCursor,omitempty"`
+	HasMore    bool        `json:"hasMore"`
+}
+
+// cursorPayload is the JSON structure encoded into an opaque cursor string.
+// Before marks that the cursor was issued as a PrevCursor and so pagination
+// should proceed backward from the encoded values.
+type cursorPayload struct {
+	Values []interface{} `json:"v"`
+	Before bool          `json:"before,omitempty"`
+}
+
+// WithCursor sets the cursor a PaginateCursor call should resume from. The
+// cursor is an opaque string previously returned as NextCursor or PrevCursor.
+func WithCursor(cursor string) Option {
+	return func(p *paginator) {
+		p.cursor = cursor
+	}
+}
+
+// WithCursorColumns sets the columns used to build the keyset predicate and
+// ORDER BY clause for PaginateCursor. Columns are applied in the given order
+// and must reference a unique (or unique enough) key to keep pagination
+// stable across calls.
+func WithCursorColumns(cols ...CursorColumn) Option {
+	return func(p *paginator) {
+		p.cursorColumns = cols
+	}
+}
+
+// PaginateCursor paginates value using keyset/cursor pagination instead of
+// OFFSET/LIMIT. It requires WithCursorColumns to be set and fetches
+// limit+1 rows to determine HasMore without a separate COUNT query.
+//     var v []SomeModel
+//     p := paginator.New(db, paginator.WithCursorColumns(paginator.CursorColumn{Name: "ID"}))
+//     res, err := p.PaginateCursor(&v)
+func (p *paginator) PaginateCursor(value interface{}) (*CursorResult, error) {
+	if len(p.cursorColumns) == 0 {
+		return nil, errors.New("paginator: PaginateCursor requires WithCursorColumns")
+	}
+
+	var (
+		values   []interface{}
+		backward bool
+	)
+
+	if p.cursor != "" {
+		payload, err := decodeCursor(p.cursor)
+		if err != nil {
+			return nil, err
+		}
+		values = payload.Values
+		backward = payload.Before
+	}
+
+	stmt := &gorm.Statement{DB: p.db}
+	if err := stmt.Parse(value); err != nil {
+		return nil, err
+	}
+
+	sqlCols := make([]CursorColumn, len(p.cursorColumns))
+	for i, c := range p.cursorColumns {
+		sqlCols[i] = c
+		if field := stmt.Schema.LookUpField(c.Name); field != nil {
+			sqlCols[i].Name = field.DBName
+		}
+	}
+
+	db := p.db.Session(&gorm.Session{})
+	for _, o := range buildCursorOrder(sqlCols, backward) {
+		db = db.Order(o)
+	}
+
+	if values != nil {
+		typedValues, err := retypeCursorValues(value, p.cursorColumns, values)
+		if err != nil {
+			return nil, err
+		}
+
+		where, args := buildCursorPredicate(sqlCols, typedValues, backward)
+		db = db.Where(where, args...)
+	}
+
+	if err := db.Limit(p.limit + 1).Find(value).Error; err != nil {
+		return nil, err
+	}
+
+	return newCursorResult(p.limit, p.cursorColumns, value, backward)
+}
+
+// buildCursorOrder builds the ORDER BY clauses for the given cursor columns,
+// flipping every direction when paginating backward so the limited query
+// reads the correct end of the keyset.
+func buildCursorOrder(cols []CursorColumn, backward bool) []string {
+	order := make([]string, len(cols))
+
+	for i, c := range cols {
+		dir := c.Direction
+		if backward {
+			dir = reverseDirection(dir)
+		}
+
+		if dir == Desc {
+			order[i] = fmt.Sprintf("%s DESC", c.Name)
+		} else {
+			order[i] = fmt.Sprintf("%s ASC", c.Name)
+		}
+	}
+
+	return order
+}
+
+// buildCursorPredicate builds the standard OR-expanded keyset predicate for
+// the given cursor columns and values:
+//     (c1 op1 v1) OR (c1 = v1 AND c2 op2 v2) OR (c1 = v1 AND c2 = v2 AND c3 op3 v3) OR ...
+// Each column's own operator is flipped for a descending column and, for
+// every column, for backward pagination. A single shared operator across the
+// whole tuple only works when every column sorts the same direction; this
+// form is correct for arbitrary per-column directions.
+func buildCursorPredicate(cols []CursorColumn, values []interface{}, backward bool) (string, []interface{}) {
+	var clauses []string
+	var args []interface{}
+
+	for i, c := range cols {
+		dir := c.Direction
+		if backward {
+			dir = reverseDirection(dir)
+		}
+
+		op := ">"
+		if dir == Desc {
+			op = "<"
+		}
+
+		parts := make([]string, 0, i+1)
+		for j := 0; j < i; j++ {
+			parts = append(parts, fmt.Sprintf("%s = ?", cols[j].Name))
+			args = append(args, values[j])
+		}
+
+		parts = append(parts, fmt.Sprintf("%s %s ?", c.Name, op))
+		args = append(args, values[i])
+
+		clauses = append(clauses, "("+strings.Join(parts, " AND ")+")")
+	}
+
+	return strings.Join(clauses, " OR "), args
+}
+
+// reverseDirection flips Asc to Desc and vice versa.
+func reverseDirection(d CursorDirection) CursorDirection {
+	if d == Asc {
+		return Desc
+	}
+	return Asc
+}
+
+// newCursorResult trims the fetched rows down to limit, detects HasMore,
+// restores natural order for backward pagination and encodes the next/prev
+// cursors out of the first and last rows.
+func newCursorResult(limit int, cols []CursorColumn, value interface{}, backward bool) (*CursorResult, error) {
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Slice {
+		return nil, errors.New("paginator: PaginateCursor expects a pointer to a slice")
+	}
+
+	slice := rv.Elem()
+	hasMore := slice.Len() > limit
+
+	if hasMore {
+		slice.Set(slice.Slice(0, limit))
+	}
+
+	if backward {
+		reverseSlice(slice)
+	}
+
+	res := &CursorResult{Records: value, HasMore: hasMore}
+
+	n := slice.Len()
+	if n == 0 {
+		return res, nil
+	}
+
+	first, err := cursorValues(slice.Index(0), cols)
+	if err != nil {
+		return nil, err
+	}
+
+	last, err := cursorValues(slice.Index(n-1), cols)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.NextCursor, err = encodeCursor(last, false); err != nil {
+		return nil, err
+	}
+
+	if res.PrevCursor, err = encodeCursor(first, true); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// cursorValues extracts the field values named by cols from a struct value,
+// in order.
+func cursorValues(v reflect.Value, cols []CursorColumn) ([]interface{}, error) {
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+
+	values := make([]interface{}, len(cols))
+	for i, c := range cols {
+		field := v.FieldByName(c.Name)
+		if !field.IsValid() {
+			return nil, fmt.Errorf("paginator: cursor column %q not found on record", c.Name)
+		}
+		values[i] = field.Interface()
+	}
+
+	return values, nil
+}
+
+// retypeCursorValues converts values decoded off an incoming cursor back into
+// the destination slice's element field types. encoding/json decodes
+// cursorPayload.Values into plain interface{}, which only ever yields JSON's
+// own string/float64/bool/etc. representations; a field type with its own
+// JSON marshaling, most importantly time.Time, would otherwise be bound to
+// the query as a bare string rather than the field's real type. value is the
+// same pointer-to-slice passed to PaginateCursor.
+func retypeCursorValues(value interface{}, cols []CursorColumn, values []interface{}) ([]interface{}, error) {
+	elemType := reflect.TypeOf(value)
+	for elemType.Kind() == reflect.Ptr || elemType.Kind() == reflect.Slice {
+		elemType = elemType.Elem()
+	}
+
+	sample := reflect.New(elemType).Elem()
+
+	typed := make([]interface{}, len(values))
+	for i, c := range cols {
+		field := sample.FieldByName(c.Name)
+		if !field.IsValid() {
+			return nil, fmt.Errorf("paginator: cursor column %q not found on record", c.Name)
+		}
+
+		raw, err := json.Marshal(values[i])
+		if err != nil {
+			return nil, fmt.Errorf("paginator: invalid cursor value for %q: %w", c.Name, err)
+		}
+
+		dest := reflect.New(field.Type())
+		if err := json.Unmarshal(raw, dest.Interface()); err != nil {
+			return nil, fmt.Errorf("paginator: invalid cursor value for %q: %w", c.Name, err)
+		}
+
+		typed[i] = dest.Elem().Interface()
+	}
+
+	return typed, nil
+}
+
+// reverseSlice reverses a slice value in place.
+func reverseSlice(s reflect.Value) {
+	for i, j := 0, s.Len()-1; i < j; i, j = i+1, j-1 {
+		tmp := reflect.New(s.Index(i).Type()).Elem()
+		tmp.Set(s.Index(i))
+		s.Index(i).Set(s.Index(j))
+		s.Index(j).Set(tmp)
+	}
+}
+
+// encodeCursor encodes values as an opaque base64 cursor string.
+func encodeCursor(values []interface{}, before bool) (string, error) {
+	data, err := json.Marshal(cursorPayload{Values: values, Before: before})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.URLEncoding.EncodeToString(data), nil
+}
+
+// decodeCursor decodes an opaque cursor string produced by encodeCursor.
+func decodeCursor(cursor string) (*cursorPayload, error) {
+	data, err := base64.URLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("paginator: invalid cursor: %w", err)
+	}
+
+	var payload cursorPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, fmt.Errorf("paginator: invalid cursor: %w", err)
+	}
+
+	return &payload, nil
+}
@@ -0,0 +1,77 @@
+package paginator
+
+import (
+	"testing"
+	"time"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type cursorEvent struct {
+	ID        uint
+	CreatedAt time.Time
+}
+
+func openCursorTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+
+	if err := db.AutoMigrate(&cursorEvent{}); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 10; i++ {
+		event := cursorEvent{CreatedAt: base.Add(time.Duration(i) * time.Hour)}
+		if err := db.Create(&event).Error; err != nil {
+			t.Fatalf("seed: %v", err)
+		}
+	}
+
+	return db
+}
+
+// TestPaginateCursorTimeColumn covers a non-string, non-int cursor column
+// against a real driver. CreatedAt round-trips through the cursor as a JSON
+// string, so PaginateCursor must re-type it back to time.Time before binding it
+// as a query arg, or the comparison against the driver's own stored
+// representation silently matches nothing.
+func TestPaginateCursorTimeColumn(t *testing.T) {
+	db := openCursorTestDB(t)
+
+	var page1 []cursorEvent
+	p := New(db, WithLimit(5), WithCursorColumns(CursorColumn{Name: "CreatedAt"}))
+
+	res, err := p.PaginateCursor(&page1)
+	if err != nil {
+		t.Fatalf("page 1: %v", err)
+	}
+	if len(page1) != 5 {
+		t.Fatalf("page 1 len = %d, want 5", len(page1))
+	}
+	if !res.HasMore {
+		t.Fatalf("page 1 HasMore = false, want true")
+	}
+
+	var page2 []cursorEvent
+	p2 := New(db, WithLimit(5), WithCursor(res.NextCursor), WithCursorColumns(CursorColumn{Name: "CreatedAt"}))
+
+	res2, err := p2.PaginateCursor(&page2)
+	if err != nil {
+		t.Fatalf("page 2: %v", err)
+	}
+	if len(page2) != 5 {
+		t.Fatalf("page 2 len = %d, want 5", len(page2))
+	}
+	if res2.HasMore {
+		t.Fatalf("page 2 HasMore = true, want false")
+	}
+	if page2[0].ID == page1[len(page1)-1].ID {
+		t.Fatalf("page 2 repeats page 1's last row (ID %d)", page2[0].ID)
+	}
+}
@@ -0,0 +1,59 @@
+package paginator
+
+import "testing"
+
+func TestBuildCursorPredicateMixedDirections(t *testing.T) {
+	cols := []CursorColumn{
+		{Name: "Status", Direction: Asc},
+		{Name: "ID", Direction: Desc},
+	}
+	values := []interface{}{"open", 3}
+
+	where, args := buildCursorPredicate(cols, values, false)
+
+	wantWhere := "(Status > ?) OR (Status = ? AND ID < ?)"
+	if where != wantWhere {
+		t.Fatalf("where = %q, want %q", where, wantWhere)
+	}
+
+	wantArgs := []interface{}{"open", "open", 3}
+	if len(args) != len(wantArgs) {
+		t.Fatalf("args = %v, want %v", args, wantArgs)
+	}
+	for i := range wantArgs {
+		if args[i] != wantArgs[i] {
+			t.Fatalf("args[%d] = %v, want %v", i, args[i], wantArgs[i])
+		}
+	}
+}
+
+func TestBuildCursorPredicateBackwardFlipsEveryColumn(t *testing.T) {
+	cols := []CursorColumn{
+		{Name: "Status", Direction: Asc},
+		{Name: "ID", Direction: Desc},
+	}
+	values := []interface{}{"open", 3}
+
+	where, _ := buildCursorPredicate(cols, values, true)
+
+	wantWhere := "(Status < ?) OR (Status = ? AND ID > ?)"
+	if where != wantWhere {
+		t.Fatalf("where = %q, want %q", where, wantWhere)
+	}
+}
+
+func TestBuildCursorOrderMixedDirections(t *testing.T) {
+	cols := []CursorColumn{
+		{Name: "Status", Direction: Asc},
+		{Name: "ID", Direction: Desc},
+	}
+
+	order := buildCursorOrder(cols, false)
+
+	want := []string{"Status ASC", "ID DESC"}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("order[%d] = %q, want %q", i, order[i], want[i])
+		}
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	paginator "github.com/dotdevgo/gorm-paginator"
-	// _ "github.com/jinzhu/gorm/dialects/mysql"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 )
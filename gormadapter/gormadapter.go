@@ -0,0 +1,50 @@
+// Package gormadapter implements paginator.Adapter against gorm.io/gorm,
+// letting paginator.NewAdapter paginate a gorm.io/gorm query with the same
+// page-math and result shaping paginator.New uses internally.
+package gormadapter
+
+import (
+	"gorm.io/gorm"
+
+	paginator "github.com/dotdevgo/gorm-paginator"
+)
+
+// Adapter implements paginator.Adapter against a gorm.io/gorm query.
+type Adapter struct {
+	db    *gorm.DB
+	value interface{}
+}
+
+var (
+	_ paginator.Adapter           = (*Adapter)(nil)
+	_ paginator.EstimatingAdapter = (*Adapter)(nil)
+)
+
+// New creates an Adapter for value out of db. db should already carry any
+// WHERE and ORDER BY clauses the query needs; Adapter only applies Count's
+// and Slice's own bounds.
+//     p := paginator.NewAdapter(gormadapter.New(db.Order("name"), &v), paginator.WithPage(2))
+//     res, err := p.Paginate(&v)
+func New(db *gorm.DB, value interface{}) *Adapter {
+	return &Adapter{db: db, value: value}
+}
+
+// Count implements paginator.Adapter. It runs concurrently with Slice, so it
+// works off its own session copy of db rather than chaining off the shared
+// handle directly.
+func (a *Adapter) Count() (int64, error) {
+	var total int64
+	err := a.db.Session(&gorm.Session{}).Model(a.value).Count(&total).Error
+	return total, err
+}
+
+// Slice implements paginator.Adapter.
+func (a *Adapter) Slice(offset, limit int, dest interface{}) error {
+	return a.db.Session(&gorm.Session{}).Offset(offset).Limit(limit).Find(dest).Error
+}
+
+// Estimate implements paginator.EstimatingAdapter. Like Count, it runs
+// concurrently with Slice and so works off its own session copy of db.
+func (a *Adapter) Estimate() (int64, error) {
+	return paginator.EstimateRows(a.db.Session(&gorm.Session{}), a.value)
+}
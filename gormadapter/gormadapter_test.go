@@ -0,0 +1,80 @@
+package gormadapter
+
+import (
+	"sync"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type widget struct {
+	ID   uint
+	Name string
+}
+
+func openTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+
+	if err := db.AutoMigrate(&widget{}); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	for i := 0; i < 5; i++ {
+		if err := db.Create(&widget{Name: "widget"}).Error; err != nil {
+			t.Fatalf("seed: %v", err)
+		}
+	}
+
+	return db
+}
+
+// TestAdapterConcurrentCountAndSlice exercises the same pattern
+// paginateViaAdapter uses: Count and Slice chained off a shared,
+// already-conditioned *gorm.DB (as New's doc recommends) and run from
+// separate goroutines. Run with -race; before Count cloned its own session,
+// this reliably raced on db's shared Statement.
+func TestAdapterConcurrentCountAndSlice(t *testing.T) {
+	db := openTestDB(t).Where("name = ?", "widget")
+	a := New(db, &[]widget{})
+
+	var (
+		wg       sync.WaitGroup
+		total    int64
+		countErr error
+		dest     []widget
+		sliceErr error
+	)
+
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		total, countErr = a.Count()
+	}()
+
+	go func() {
+		defer wg.Done()
+		sliceErr = a.Slice(0, 2, &dest)
+	}()
+
+	wg.Wait()
+
+	if countErr != nil {
+		t.Fatalf("Count: %v", countErr)
+	}
+	if sliceErr != nil {
+		t.Fatalf("Slice: %v", sliceErr)
+	}
+	if total != 5 {
+		t.Fatalf("total = %d, want 5", total)
+	}
+	if len(dest) != 2 {
+		t.Fatalf("len(dest) = %d, want 2", len(dest))
+	}
+}
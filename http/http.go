@@ -0,0 +1,132 @@
+// Package http binds paginator options to an *http.Request and writes
+// pagination metadata back onto an http.ResponseWriter.
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	paginator "github.com/dotdevgo/gorm-paginator"
+)
+
+// DefaultMaxLimit is the limit query param's upper bound when Binder.MaxLimit
+// is unset.
+const DefaultMaxLimit = 100
+
+// Binder constructs paginator options from an *http.Request. The zero value
+// falls back to paginator.DefaultLimit and DefaultMaxLimit, and rejects every
+// sort column until AllowedSortColumns is set.
+type Binder struct {
+	// DefaultLimit is used when the request omits the limit query param. It
+	// defaults to paginator.DefaultLimit.
+	DefaultLimit int
+
+	// MaxLimit caps the limit query param, regardless of what the request
+	// asks for. It defaults to DefaultMaxLimit.
+	MaxLimit int
+
+	// AllowedSortColumns allow-lists the columns accepted by the sort query
+	// param, preventing SQL injection through paginator.WithOrder. Columns
+	// not present here are silently dropped.
+	AllowedSortColumns map[string]bool
+}
+
+// FromRequest constructs paginator options out of r's page, limit and sort
+// query params. sort is a comma-separated list of columns, prefixed with "-"
+// for descending order, e.g. "sort=-createdAt,name".
+//     b := &http.Binder{AllowedSortColumns: map[string]bool{"name": true}}
+//     res, err := paginator.Paginate(db, &v, b.FromRequest(r)...)
+func (b *Binder) FromRequest(r *http.Request) []paginator.Option {
+	q := r.URL.Query()
+
+	opts := []paginator.Option{
+		paginator.WithLimit(b.limit(q.Get("limit"))),
+	}
+
+	if page, err := strconv.Atoi(q.Get("page")); err == nil && page > 0 {
+		opts = append(opts, paginator.WithPage(page))
+	}
+
+	for _, field := range strings.Split(q.Get("sort"), ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+
+		dir := "ASC"
+		if strings.HasPrefix(field, "-") {
+			dir = "DESC"
+			field = field[1:]
+		}
+
+		if !b.AllowedSortColumns[field] {
+			continue
+		}
+
+		opts = append(opts, paginator.WithOrder(fmt.Sprintf("%s %s", field, dir)))
+	}
+
+	return opts
+}
+
+// limit resolves the effective limit for a raw "limit" query param value,
+// falling back to DefaultLimit and capping at MaxLimit.
+func (b *Binder) limit(raw string) int {
+	limit, err := strconv.Atoi(raw)
+	if err != nil || limit <= 0 {
+		limit = b.DefaultLimit
+		if limit <= 0 {
+			limit = paginator.DefaultLimit
+		}
+	}
+
+	max := b.MaxLimit
+	if max <= 0 {
+		max = DefaultMaxLimit
+	}
+
+	if limit > max {
+		limit = max
+	}
+
+	return limit
+}
+
+// WriteHeaders writes RFC 5988 Link headers (rel="next", "prev", "first",
+// "last") for res, along with X-Total-Count and X-Page headers. Link targets
+// are built from r's URL with its page query param replaced.
+func WriteHeaders(w http.ResponseWriter, r *http.Request, res *paginator.Result) {
+	w.Header().Set("X-Total-Count", strconv.FormatInt(res.TotalRecords, 10))
+	w.Header().Set("X-Page", strconv.Itoa(res.CurrentPage))
+
+	var links []string
+
+	if res.HasPrev {
+		links = append(links, fmt.Sprintf(`<%s>; rel="prev"`, pageLink(r, res.CurrentPage-1)))
+	}
+
+	if res.HasNext {
+		links = append(links, fmt.Sprintf(`<%s>; rel="next"`, pageLink(r, res.CurrentPage+1)))
+	}
+
+	// MaxPage is 0 when res.TotalRecords is unknown (e.g. CountNone), in which
+	// case there's no valid last page number to link to.
+	if res.MaxPage > 0 {
+		links = append(links, fmt.Sprintf(`<%s>; rel="first"`, pageLink(r, 1)))
+		links = append(links, fmt.Sprintf(`<%s>; rel="last"`, pageLink(r, res.MaxPage)))
+	}
+
+	w.Header().Set("Link", strings.Join(links, ", "))
+}
+
+// pageLink builds the URL for r with its page query param set to page.
+func pageLink(r *http.Request, page int) string {
+	u := *r.URL
+	q := u.Query()
+	q.Set("page", strconv.Itoa(page))
+	u.RawQuery = q.Encode()
+
+	return u.String()
+}
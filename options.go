@@ -0,0 +1,42 @@
+package paginator
+
+// Option configures a paginator.
+type Option func(*paginator)
+
+// WithPage sets the page to retrieve. Pages start at 1.
+func WithPage(page int) Option {
+	return func(p *paginator) {
+		p.page = page
+	}
+}
+
+// WithLimit sets the number of records per page.
+func WithLimit(limit int) Option {
+	return func(p *paginator) {
+		p.limit = limit
+	}
+}
+
+// WithOrder appends an ORDER BY clause, e.g. "name DESC".
+func WithOrder(order string) Option {
+	return func(p *paginator) {
+		p.order = append(p.order, order)
+	}
+}
+
+// WithPageWindow sets how many page numbers Result.PageWindow includes
+// before and after CurrentPage. It defaults to 5 pages on either side.
+func WithPageWindow(prev, next int) Option {
+	return func(p *paginator) {
+		p.pageWindowPrev = prev
+		p.pageWindowNext = next
+	}
+}
+
+// WithCountStrategy sets how the paginator counts total records. It
+// defaults to CountExact.
+func WithCountStrategy(strategy CountStrategy) Option {
+	return func(p *paginator) {
+		p.countStrategy = strategy
+	}
+}
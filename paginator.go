@@ -1,15 +1,25 @@
 // Package paginator provides a simple paginator implementation for gorm. It
 // also supports configuring the paginator via http.Request query params.
+//
+// Migration note: as of this version, paginator targets gorm.io/gorm (gorm
+// v2) rather than github.com/jinzhu/gorm (gorm v1). Callers only need to
+// update their own gorm import; TotalRecords on Result is now an int64,
+// matching the type gorm v2's Count returns.
 package paginator
 
 import (
-	"github.com/jinzhu/gorm"
+	"gorm.io/gorm"
 )
 
 // DefaultLimit defines the default limit for paginated queries. This is a
 // variable so that users can configure it at runtime.
 var DefaultLimit = 20
 
+// DefaultPageWindow defines the default number of pages Result.PageWindow
+// includes before and after CurrentPage. This is a variable so that users
+// can configure it at runtime.
+var DefaultPageWindow = 5
+
 // Paginator defines the interface for a paginator.
 type Paginator interface {
 	// Paginate takes a value as arguments and returns a paginated result
@@ -22,20 +32,91 @@ type Paginator interface {
 	// foreignKey to join the related values. This works for has-many and
 	// many-to-many relations.
 	PaginateRelated(value interface{}, related interface{}, foreignKey string) (*Result, error)
+
+	// PaginateCursor takes a value as argument and returns a paginated result
+	// using keyset/cursor pagination, configured via WithCursor and
+	// WithCursorColumns, instead of OFFSET/LIMIT.
+	PaginateCursor(value interface{}) (*CursorResult, error)
+}
+
+// Adapter abstracts the data source a paginator reads from, decoupling the
+// offset/limit page math and result shaping from gorm. Implementations are
+// expected to already carry their own filtering and ordering; Count and
+// Slice only add the paginator's own bounds.
+type Adapter interface {
+	// Count returns the total number of records available, ignoring offset
+	// and limit.
+	Count() (int64, error)
+
+	// Slice fetches limit records starting at offset into dest.
+	Slice(offset, limit int, dest interface{}) error
+}
+
+// AdapterPaginator defines the interface for a paginator backed by a custom
+// Adapter instead of a *gorm.DB.
+type AdapterPaginator interface {
+	// Paginate takes a value as argument and returns a paginated result
+	// containing records of the value type.
+	Paginate(value interface{}) (*Result, error)
+}
+
+// adapterPaginator defines a paginator backed by an Adapter.
+type adapterPaginator struct {
+	adapter        Adapter
+	limit          int
+	page           int
+	pageWindowPrev int
+	pageWindowNext int
+	countStrategy  CountStrategy
+}
+
+// dbAdapter is the default Adapter backing paginator.Paginate, built from the
+// paginator's own *gorm.DB and options. The gormadapter subpackage ships the
+// same behavior as a standalone, public Adapter for callers who want to
+// build a paginator directly from an Adapter instead of a *gorm.DB.
+type dbAdapter struct {
+	db    *gorm.DB
+	value interface{}
+}
+
+// Count implements Adapter. It runs concurrently with Slice, so it works off
+// its own session copy of db rather than chaining off the shared handle
+// directly.
+func (a *dbAdapter) Count() (int64, error) {
+	var total int64
+	err := a.db.Session(&gorm.Session{}).Model(a.value).Count(&total).Error
+	return total, err
+}
+
+// Slice implements Adapter.
+func (a *dbAdapter) Slice(offset, limit int, dest interface{}) error {
+	return a.db.Session(&gorm.Session{}).Limit(limit).Offset(offset).Find(dest).Error
+}
+
+// Estimate implements EstimatingAdapter. Like Count, it runs concurrently
+// with Slice and so works off its own session copy of db.
+func (a *dbAdapter) Estimate() (int64, error) {
+	return EstimateRows(a.db.Session(&gorm.Session{}), a.value)
 }
 
 // paginator defines a paginator.
 type paginator struct {
-	db    *gorm.DB
-	limit int
-	page  int
-	order []string
+	db             *gorm.DB
+	limit          int
+	page           int
+	order          []string
+	cursor         string
+	cursorColumns  []CursorColumn
+	pageWindowPrev int
+	pageWindowNext int
+	countStrategy  CountStrategy
 }
 
 // countResult defines the result of the count query executed by the paginator.
 type countResult struct {
-	total int
-	err   error
+	total    int64
+	estimate bool
+	err      error
 }
 
 // Result defines a paginated result.
@@ -43,8 +124,23 @@ type Result struct {
 	CurrentPage    int         `json:"currentPage"`
 	MaxPage        int         `json:"maxPage"`
 	RecordsPerPage int         `json:"recordsPerPage"`
-	TotalRecords   int         `json:"totalRecords"`
+	TotalRecords   int64       `json:"totalRecords"`
 	Records        interface{} `json:"records"`
+	HasNext        bool        `json:"hasNext"`
+	HasPrev        bool        `json:"hasPrev"`
+	NextPage       int         `json:"nextPage"`
+	PrevPage       int         `json:"prevPage"`
+	FirstItem      int64       `json:"firstItem"`
+	LastItem       int64       `json:"lastItem"`
+	// PageWindow is a bounded window of page numbers around CurrentPage,
+	// sized by WithPageWindow (DefaultPageWindow pages on either side by
+	// default), for rendering a pagination bar without re-deriving the
+	// arithmetic.
+	PageWindow []int `json:"pageWindow"`
+	// IsEstimate is true when TotalRecords came from CountEstimate through an
+	// EstimatingAdapter. It is also true where EstimateRows fell back to an
+	// exact COUNT(*) for a dialect other than Postgres or MySQL.
+	IsEstimate bool `json:"isEstimate"`
 }
 
 // New create a new value of the Paginator type. It expects a gorm DB handle
@@ -54,10 +150,13 @@ type Result struct {
 //     res, err := p.Paginate(&v)
 func New(db *gorm.DB, options ...Option) Paginator {
 	p := &paginator{
-		db:    db,
-		page:  1,
-		limit: DefaultLimit,
-		order: make([]string, 0),
+		db:             db,
+		page:           1,
+		limit:          DefaultLimit,
+		order:          make([]string, 0),
+		pageWindowPrev: DefaultPageWindow,
+		pageWindowNext: DefaultPageWindow,
+		countStrategy:  CountExact,
 	}
 
 	for _, option := range options {
@@ -67,6 +166,35 @@ func New(db *gorm.DB, options ...Option) Paginator {
 	return p
 }
 
+// NewAdapter creates a new AdapterPaginator backed by adapter. This allows
+// paginating raw database/sql queries, in-memory slices, Mongo cursors, or
+// any other data source with the same page-math and result shaping Paginate
+// uses for gorm, by implementing Adapter.
+//     p := paginator.NewAdapter(gormadapter.New(db.Order("name"), &v), paginator.WithPage(2))
+//     res, err := p.Paginate(&v)
+func NewAdapter(adapter Adapter, options ...Option) AdapterPaginator {
+	p := &paginator{
+		page:           1,
+		limit:          DefaultLimit,
+		pageWindowPrev: DefaultPageWindow,
+		pageWindowNext: DefaultPageWindow,
+		countStrategy:  CountExact,
+	}
+
+	for _, option := range options {
+		option(p)
+	}
+
+	return &adapterPaginator{
+		adapter:        adapter,
+		limit:          p.limit,
+		page:           p.page,
+		pageWindowPrev: p.pageWindowPrev,
+		pageWindowNext: p.pageWindowNext,
+		countStrategy:  p.countStrategy,
+	}
+}
+
 // Paginate is a convenience wrapper for the paginator.
 //     var v []SomeModel
 //     res, err := paginator.Paginate(db, &v, paginator.WithPage(2))
@@ -84,16 +212,32 @@ func PaginateRelated(db *gorm.DB, value interface{}, related interface{}, foreig
 
 // Paginate implements the Paginator interface.
 func (p *paginator) Paginate(value interface{}) (*Result, error) {
-	db := p.prepareDB()
+	adapter := &dbAdapter{db: p.prepareDB(), value: value}
+	return paginateViaAdapter(adapter, p.page, p.limit, p.pageWindowPrev, p.pageWindowNext, p.countStrategy, value)
+}
+
+// Paginate implements the AdapterPaginator interface.
+func (p *adapterPaginator) Paginate(value interface{}) (*Result, error) {
+	return paginateViaAdapter(p.adapter, p.page, p.limit, p.pageWindowPrev, p.pageWindowNext, p.countStrategy, value)
+}
+
+// paginateViaAdapter runs the count strategy and adapter.Slice concurrently
+// and shapes the result, shared by both the gorm-backed paginator and
+// adapterPaginator. CountNone skips the count goroutine entirely.
+func paginateViaAdapter(adapter Adapter, page, limit, pageWindowPrev, pageWindowNext int, strategy CountStrategy, value interface{}) (*Result, error) {
+	if _, skip := strategy.(interface{ skipsCount() }); skip {
+		if err := adapter.Slice(calcOffset(page, limit), limit, value); err != nil {
+			return nil, err
+		}
+
+		return newResult(page, limit, pageWindowPrev, pageWindowNext, value, -1, false), nil
+	}
 
 	c := make(chan countResult, 1)
 
-	go countRecords(db, value, c)
+	go countViaStrategy(strategy, adapter, value, c)
 
-	err := db.Limit(p.limit).
-		Offset(p.offset()).
-		Find(value).
-		Error
+	err := adapter.Slice(calcOffset(page, limit), limit, value)
 
 	countResult := <-c
 	if countResult.err != nil {
@@ -104,7 +248,7 @@ func (p *paginator) Paginate(value interface{}) (*Result, error) {
 		return nil, err
 	}
 
-	return newResult(p, value, countResult.total), nil
+	return newResult(page, limit, pageWindowPrev, pageWindowNext, value, countResult.total, countResult.estimate), nil
 }
 
 // PaginateRelated implements the Paginator interface.
@@ -120,11 +264,12 @@ func (p *paginator) PaginateRelated(value interface{}, related interface{}, fore
 
 	go countRelatedRecords(assoc, c)
 
-	err := db.Model(value).
+	err := db.Session(&gorm.Session{}).
+		Model(value).
 		Limit(p.limit).
 		Offset(p.offset()).
-		Related(related, foreignKey).
-		Error
+		Association(foreignKey).
+		Find(related)
 
 	countResult := <-c
 	if countResult.err != nil {
@@ -135,12 +280,14 @@ func (p *paginator) PaginateRelated(value interface{}, related interface{}, fore
 		return nil, err
 	}
 
-	return newResult(p, related, countResult.total), nil
+	return newResult(p.page, p.limit, p.pageWindowPrev, p.pageWindowNext, related, countResult.total, false), nil
 }
 
-// prepareDB prepares the statement by adding the order clauses.
+// prepareDB prepares the statement by adding the order clauses. It works off
+// a session copy of the paginator's db so ordering never mutates the shared
+// handle.
 func (p *paginator) prepareDB() *gorm.DB {
-	db := p.db
+	db := p.db.Session(&gorm.Session{})
 
 	for _, o := range p.order {
 		db = db.Order(o)
@@ -151,14 +298,19 @@ func (p *paginator) prepareDB() *gorm.DB {
 
 // offset computes the offset used for the paginated query.
 func (p *paginator) offset() int {
-	return (p.page - 1) * p.limit
+	return calcOffset(p.page, p.limit)
+}
+
+// calcOffset computes the offset for the given page and limit.
+func calcOffset(page, limit int) int {
+	return (page - 1) * limit
 }
 
-// countRecords counts the result rows for given query and returns the result
-// in the provided channel.
-func countRecords(db *gorm.DB, value interface{}, c chan<- countResult) {
+// countViaStrategy runs strategy against adapter and returns the result in
+// the provided channel.
+func countViaStrategy(strategy CountStrategy, adapter Adapter, value interface{}, c chan<- countResult) {
 	var result countResult
-	result.err = db.Model(value).Count(&result.total).Error
+	result.total, result.estimate, result.err = strategy.count(adapter, value)
 	c <- result
 }
 
@@ -171,10 +323,28 @@ func countRelatedRecords(assoc *gorm.Association, c chan<- countResult) {
 	c <- result
 }
 
-// newResult creates a new Result out of the retrieved value, the total number
-// of records and the paginator's options.
-func newResult(p *paginator, value interface{}, total int) *Result {
-	maxPageF := float64(total) / float64(p.limit)
+// newResult creates a new Result out of the retrieved value, the total
+// number of records, and the page, limit and page window it was fetched
+// with. A negative total (as produced by CountNone) skips the page-count
+// arithmetic, leaving MaxPage at 0.
+func newResult(page, limit, pageWindowPrev, pageWindowNext int, value interface{}, total int64, isEstimate bool) *Result {
+	if total < 0 {
+		res := &Result{
+			TotalRecords:   total,
+			Records:        value,
+			CurrentPage:    page,
+			RecordsPerPage: limit,
+			HasPrev:        page > 1,
+		}
+
+		if res.HasPrev {
+			res.PrevPage = page - 1
+		}
+
+		return res
+	}
+
+	maxPageF := float64(total) / float64(limit)
 	maxPage := int(maxPageF)
 
 	if float64(maxPage) < maxPageF {
@@ -183,13 +353,69 @@ func newResult(p *paginator, value interface{}, total int) *Result {
 		maxPage = 1
 	}
 
+	hasNext := page < maxPage
+	hasPrev := page > 1
+
+	nextPage := 0
+	if hasNext {
+		nextPage = page + 1
+	}
+
+	prevPage := 0
+	if hasPrev {
+		prevPage = page - 1
+	}
+
+	var firstItem, lastItem int64
+	offset := int64(calcOffset(page, limit))
+	if total > 0 && offset < total {
+		firstItem = offset + 1
+		lastItem = firstItem + int64(limit) - 1
+		if lastItem > total {
+			lastItem = total
+		}
+	}
+
 	return &Result{
 		TotalRecords:   total,
 		Records:        value,
-		CurrentPage:    p.page,
-		RecordsPerPage: p.limit,
+		CurrentPage:    page,
+		RecordsPerPage: limit,
 		MaxPage:        maxPage,
+		HasNext:        hasNext,
+		HasPrev:        hasPrev,
+		NextPage:       nextPage,
+		PrevPage:       prevPage,
+		FirstItem:      firstItem,
+		LastItem:       lastItem,
+		PageWindow:     buildPageWindow(page, maxPage, pageWindowPrev, pageWindowNext),
+		IsEstimate:     isEstimate,
+	}
+}
+
+// buildPageWindow returns the page numbers from pageWindowPrev pages before
+// current to pageWindowNext pages after it, clamped to [1, maxPage].
+func buildPageWindow(current, maxPage, pageWindowPrev, pageWindowNext int) []int {
+	start := current - pageWindowPrev
+	if start < 1 {
+		start = 1
 	}
+
+	end := current + pageWindowNext
+	if end > maxPage {
+		end = maxPage
+	}
+
+	if end < start {
+		return []int{}
+	}
+
+	window := make([]int, 0, end-start+1)
+	for i := start; i <= end; i++ {
+		window = append(window, i)
+	}
+
+	return window
 }
 
 // IsLastPage returns true if the current page of the result is the last page.
@@ -0,0 +1,64 @@
+package paginator
+
+import "testing"
+
+func TestNewResultPagePastMaxPage(t *testing.T) {
+	res := newResult(2, 10, 5, 5, nil, 10, false)
+
+	if res.MaxPage != 1 {
+		t.Fatalf("MaxPage = %d, want 1", res.MaxPage)
+	}
+	if res.FirstItem != 0 || res.LastItem != 0 {
+		t.Fatalf("FirstItem/LastItem = %d/%d, want 0/0", res.FirstItem, res.LastItem)
+	}
+}
+
+func TestNewResultWithinRange(t *testing.T) {
+	res := newResult(2, 10, 5, 5, nil, 25, false)
+
+	if res.FirstItem != 11 || res.LastItem != 20 {
+		t.Fatalf("FirstItem/LastItem = %d/%d, want 11/20", res.FirstItem, res.LastItem)
+	}
+	if res.MaxPage != 3 {
+		t.Fatalf("MaxPage = %d, want 3", res.MaxPage)
+	}
+}
+
+func TestNewResultLastPagePartial(t *testing.T) {
+	res := newResult(3, 10, 5, 5, nil, 25, false)
+
+	if res.FirstItem != 21 || res.LastItem != 25 {
+		t.Fatalf("FirstItem/LastItem = %d/%d, want 21/25", res.FirstItem, res.LastItem)
+	}
+	if res.HasNext {
+		t.Fatalf("HasNext = true, want false")
+	}
+}
+
+func TestBuildPageWindowClampsToBounds(t *testing.T) {
+	window := buildPageWindow(1, 3, 5, 5)
+
+	want := []int{1, 2, 3}
+	if len(window) != len(want) {
+		t.Fatalf("window = %v, want %v", window, want)
+	}
+	for i := range want {
+		if window[i] != want[i] {
+			t.Fatalf("window = %v, want %v", window, want)
+		}
+	}
+}
+
+func TestBuildPageWindowMidRange(t *testing.T) {
+	window := buildPageWindow(10, 20, 2, 2)
+
+	want := []int{8, 9, 10, 11, 12}
+	if len(window) != len(want) {
+		t.Fatalf("window = %v, want %v", window, want)
+	}
+	for i := range want {
+		if window[i] != want[i] {
+			t.Fatalf("window = %v, want %v", window, want)
+		}
+	}
+}